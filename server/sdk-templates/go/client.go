@@ -2,9 +2,22 @@
 package botbuilder
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	mathrand "math/rand"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -15,15 +28,111 @@ const Version = "1.0.0"
 
 // Config holds the client configuration
 type Config struct {
-	APIKey  string
-	BaseURL string
-	Timeout time.Duration
+	APIKey      string
+	BaseURL     string
+	Timeout     time.Duration
+	RetryPolicy *RetryPolicy
+
+	// OnRetry, if set, is called before each retry attempt with the attempt
+	// number (starting at 1) and the error that triggered it. attempt is 0
+	// for a connection failure where no response was ever received.
+	OnRetry func(attempt int, err error)
+}
+
+// RetryPolicy configures automatic retries for transient failures from
+// `/api/bots`, `/api/messages`, and other endpoints.
+type RetryPolicy struct {
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         bool
+
+	// RetryableStatuses are the HTTP status codes that trigger a retry.
+	// Defaults to 429, 500, 502, 503, and 504.
+	RetryableStatuses []int
+}
+
+// DefaultRetryPolicy returns the retry policy used when Config.RetryPolicy is nil.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         true,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// isRetryableStatus reports whether status is one of policy's RetryableStatuses,
+// falling back to the default statuses when RetryableStatuses is nil
+func (p *RetryPolicy) isRetryableStatus(status int) bool {
+	statuses := p.RetryableStatuses
+	if statuses == nil {
+		statuses = DefaultRetryPolicy().RetryableStatuses
+	}
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header in either delta-seconds
+// ("120") or HTTP-date form, returning the duration to wait and whether the
+// header was present and valid.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(when); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// noRetryHeader marks a request whose body can't be safely resent (e.g. a
+// single-use io.Reader draining a multipart file upload). The client-level
+// retry condition skips any request carrying this header, since *resty.Request
+// has no per-request retry-count override.
+const noRetryHeader = "X-BotBuilder-No-Retry"
+
+// newIdempotencyKey generates a random UUID v4 for tagging a non-idempotent
+// request so the server can deduplicate it across retries.
+func newIdempotencyKey() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("botbuilder-%d", mathrand.Int63())
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
 }
 
 // Client is the main BotBuilder API client
 type Client struct {
 	config     *Config
 	httpClient *resty.Client
+	ctx        context.Context
 	Bots       *BotsService
 	Messages   *MessagesService
 	Knowledge  *KnowledgeService
@@ -31,6 +140,30 @@ type Client struct {
 	Webhooks   *WebhooksService
 }
 
+// WithContext returns a shallow copy of the client whose non-Context service
+// methods (List, Get, Send, ...) default to ctx instead of context.Background().
+// Explicit *Context methods (ListContext, GetContext, ...) are unaffected and
+// always use the context passed to them.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	clone := *c
+	clone.ctx = ctx
+	clone.Bots = &BotsService{client: &clone}
+	clone.Messages = &MessagesService{client: &clone}
+	clone.Knowledge = &KnowledgeService{client: &clone}
+	clone.Analytics = &AnalyticsService{client: &clone}
+	clone.Webhooks = &WebhooksService{client: &clone}
+	return &clone
+}
+
+// context returns the client's default context, falling back to
+// context.Background() when WithContext has not been used.
+func (c *Client) context() context.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	return context.Background()
+}
+
 // NewClient creates a new BotBuilder client
 func NewClient(config *Config) (*Client, error) {
 	if config == nil {
@@ -53,12 +186,63 @@ func NewClient(config *Config) (*Client, error) {
 		config.Timeout = 30 * time.Second
 	}
 
+	if config.RetryPolicy == nil {
+		config.RetryPolicy = DefaultRetryPolicy()
+	}
+
 	httpClient := resty.New().
 		SetBaseURL(config.BaseURL).
 		SetTimeout(config.Timeout).
 		SetHeader("Authorization", "Bearer "+config.APIKey).
 		SetHeader("Content-Type", "application/json").
-		SetHeader("User-Agent", "BotBuilder-SDK-Go/"+Version)
+		SetHeader("User-Agent", "BotBuilder-SDK-Go/"+Version).
+		SetRetryCount(config.RetryPolicy.MaxRetries).
+		// resty clamps whatever SetRetryAfter returns into
+		// [RetryWaitTime, RetryMaxWaitTime]. Left deliberately wide so that
+		// range never interferes: SetRetryAfter below is the sole source of
+		// truth for the actual wait, including a server Retry-After that
+		// legitimately exceeds policy.MaxBackoff.
+		SetRetryWaitTime(time.Millisecond).
+		SetRetryMaxWaitTime(24 * time.Hour).
+		AddRetryCondition(func(resp *resty.Response, err error) bool {
+			if resp != nil && resp.Request != nil && resp.Request.Header.Get(noRetryHeader) != "" {
+				return false
+			}
+			if err != nil {
+				return true
+			}
+			return config.RetryPolicy.isRetryableStatus(resp.StatusCode())
+		}).
+		SetRetryAfter(func(rc *resty.Client, resp *resty.Response) (time.Duration, error) {
+			policy := config.RetryPolicy
+
+			if resp.StatusCode() == http.StatusTooManyRequests {
+				if wait, ok := parseRetryAfter(resp.Header().Get("Retry-After")); ok {
+					return wait, nil
+				}
+			}
+
+			attempt := resp.Request.Attempt
+			backoff := time.Duration(float64(policy.InitialBackoff) * math.Pow(policy.Multiplier, float64(attempt-1)))
+			if backoff > policy.MaxBackoff {
+				backoff = policy.MaxBackoff
+			}
+			if policy.Jitter {
+				backoff = time.Duration(float64(backoff) * (0.5 + mathrand.Float64()*0.5))
+			}
+
+			return backoff, nil
+		})
+
+	if config.OnRetry != nil {
+		httpClient.AddRetryHook(func(resp *resty.Response, err error) {
+			attempt := 0
+			if resp != nil && resp.Request != nil {
+				attempt = resp.Request.Attempt
+			}
+			config.OnRetry(attempt, err)
+		})
+	}
 
 	c := &Client{
 		config:     config,
@@ -93,12 +277,27 @@ type Message struct {
 
 // BotsService handles bot operations
 type BotsService struct {
-	client *Client
+	client         *Client
+	idempotencyKey string
+}
+
+// WithIdempotencyKey returns a shallow copy of the service whose Create calls
+// send key as the Idempotency-Key header instead of an auto-generated one,
+// letting callers tie retries to their own request IDs.
+func (s *BotsService) WithIdempotencyKey(key string) *BotsService {
+	clone := *s
+	clone.idempotencyKey = key
+	return &clone
 }
 
 // List returns all bots
 func (s *BotsService) List() ([]Bot, error) {
-	resp, err := s.client.httpClient.R().Get("/api/bots")
+	return s.ListContext(s.client.context())
+}
+
+// ListContext returns all bots
+func (s *BotsService) ListContext(ctx context.Context) ([]Bot, error) {
+	resp, err := s.client.httpClient.R().SetContext(ctx).Get("/api/bots")
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +312,12 @@ func (s *BotsService) List() ([]Bot, error) {
 
 // Get returns a specific bot
 func (s *BotsService) Get(botID string) (*Bot, error) {
-	resp, err := s.client.httpClient.R().Get("/api/bots/" + botID)
+	return s.GetContext(s.client.context(), botID)
+}
+
+// GetContext returns a specific bot
+func (s *BotsService) GetContext(ctx context.Context, botID string) (*Bot, error) {
+	resp, err := s.client.httpClient.R().SetContext(ctx).Get("/api/bots/" + botID)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +338,22 @@ type CreateBotRequest struct {
 
 // Create creates a new bot
 func (s *BotsService) Create(req *CreateBotRequest) (*Bot, error) {
-	resp, err := s.client.httpClient.R().SetBody(req).Post("/api/bots")
+	return s.CreateContext(s.client.context(), req)
+}
+
+// CreateContext creates a new bot. The request carries an Idempotency-Key
+// header so the server can deduplicate it if the retry policy resends it.
+func (s *BotsService) CreateContext(ctx context.Context, req *CreateBotRequest) (*Bot, error) {
+	key := s.idempotencyKey
+	if key == "" {
+		key = newIdempotencyKey()
+	}
+
+	resp, err := s.client.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Idempotency-Key", key).
+		SetBody(req).
+		Post("/api/bots")
 	if err != nil {
 		return nil, err
 	}
@@ -149,13 +368,28 @@ func (s *BotsService) Create(req *CreateBotRequest) (*Bot, error) {
 
 // Delete deletes a bot
 func (s *BotsService) Delete(botID string) error {
-	_, err := s.client.httpClient.R().Delete("/api/bots/" + botID)
+	return s.DeleteContext(s.client.context(), botID)
+}
+
+// DeleteContext deletes a bot
+func (s *BotsService) DeleteContext(ctx context.Context, botID string) error {
+	_, err := s.client.httpClient.R().SetContext(ctx).Delete("/api/bots/" + botID)
 	return err
 }
 
 // MessagesService handles message operations
 type MessagesService struct {
-	client *Client
+	client         *Client
+	idempotencyKey string
+}
+
+// WithIdempotencyKey returns a shallow copy of the service whose Send calls
+// send key as the Idempotency-Key header instead of an auto-generated one,
+// letting callers tie retries to their own request IDs.
+func (s *MessagesService) WithIdempotencyKey(key string) *MessagesService {
+	clone := *s
+	clone.idempotencyKey = key
+	return &clone
 }
 
 // SendMessageRequest is the request for sending a message
@@ -167,7 +401,22 @@ type SendMessageRequest struct {
 
 // Send sends a message
 func (s *MessagesService) Send(req *SendMessageRequest) (*Message, error) {
-	resp, err := s.client.httpClient.R().SetBody(req).Post("/api/messages")
+	return s.SendContext(s.client.context(), req)
+}
+
+// SendContext sends a message. The request carries an Idempotency-Key
+// header so the server can deduplicate it if the retry policy resends it.
+func (s *MessagesService) SendContext(ctx context.Context, req *SendMessageRequest) (*Message, error) {
+	key := s.idempotencyKey
+	if key == "" {
+		key = newIdempotencyKey()
+	}
+
+	resp, err := s.client.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Idempotency-Key", key).
+		SetBody(req).
+		Post("/api/messages")
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +431,12 @@ func (s *MessagesService) Send(req *SendMessageRequest) (*Message, error) {
 
 // List returns messages for a bot
 func (s *MessagesService) List(botID string) ([]Message, error) {
-	resp, err := s.client.httpClient.R().Get("/api/bots/" + botID + "/messages")
+	return s.ListContext(s.client.context(), botID)
+}
+
+// ListContext returns messages for a bot
+func (s *MessagesService) ListContext(ctx context.Context, botID string) ([]Message, error) {
+	resp, err := s.client.httpClient.R().SetContext(ctx).Get("/api/bots/" + botID + "/messages")
 	if err != nil {
 		return nil, err
 	}
@@ -195,11 +449,396 @@ func (s *MessagesService) List(botID string) ([]Message, error) {
 	return messages, nil
 }
 
+// MessageChunk is an incremental delta of a streamed message response
+type MessageChunk struct {
+	Delta        string `json:"delta"`
+	Role         string `json:"role,omitempty"`
+	FinishReason string `json:"finish_reason,omitempty"`
+}
+
+// MessageStream reads incremental MessageChunks off a Server-Sent Events
+// response. Callers must call Close once they are done consuming it.
+type MessageStream struct {
+	resp   *http.Response
+	reader *bufio.Reader
+}
+
+// Next blocks until the next chunk arrives, returning io.EOF once the stream
+// is fully consumed.
+func (ms *MessageStream) Next() (*MessageChunk, error) {
+	for {
+		line, err := ms.reader.ReadString('\n')
+		if err != nil {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			return nil, err
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" || !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+
+		data := strings.TrimPrefix(line, "data: ")
+		if data == "[DONE]" {
+			return nil, io.EOF
+		}
+
+		var chunk MessageChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return nil, err
+		}
+
+		return &chunk, nil
+	}
+}
+
+// Close releases the underlying HTTP connection
+func (ms *MessageStream) Close() error {
+	return ms.resp.Body.Close()
+}
+
+// doStream issues a streaming POST against path, bypassing resty (which
+// buffers the full response body) so callers can read the response as it
+// arrives. Shared by SendStream and future analytics tail endpoints.
+func (c *Client) doStream(ctx context.Context, path string, body interface{}) (*http.Response, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.BaseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.Header.Set("Authorization", "Bearer "+c.config.APIKey)
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "text/event-stream")
+	httpReq.Header.Set("User-Agent", "BotBuilder-SDK-Go/"+Version)
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("botbuilder: stream request failed with status %d: %s", resp.StatusCode, respBody)
+	}
+
+	return resp, nil
+}
+
+// SendStream sends a message and returns a MessageStream of incremental
+// token deltas instead of blocking for the full response.
+func (s *MessagesService) SendStream(ctx context.Context, req *SendMessageRequest) (*MessageStream, error) {
+	resp, err := s.client.doStream(ctx, "/api/messages/stream", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessageStream{resp: resp, reader: bufio.NewReader(resp.Body)}, nil
+}
+
+// SendStreamFunc sends a message, invoking fn with each incremental chunk as
+// it arrives, and returns the final assembled Message once the stream ends.
+func (s *MessagesService) SendStreamFunc(ctx context.Context, req *SendMessageRequest, fn func(*MessageChunk) error) (*Message, error) {
+	stream, err := s.SendStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	msg := &Message{BotID: req.BotID}
+	var content strings.Builder
+
+	for {
+		chunk, err := stream.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if chunk.Role != "" {
+			msg.Role = chunk.Role
+		}
+		content.WriteString(chunk.Delta)
+
+		if err := fn(chunk); err != nil {
+			return nil, err
+		}
+	}
+
+	msg.Content = content.String()
+	return msg, nil
+}
+
 // KnowledgeService handles knowledge base operations
 type KnowledgeService struct {
 	client *Client
 }
 
+// Document represents an ingested knowledge base document
+type Document struct {
+	ID        string            `json:"id"`
+	BotID     string            `json:"bot_id"`
+	Title     string            `json:"title"`
+	Source    string            `json:"source"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	CreatedAt string            `json:"created_at"`
+}
+
+// Chunk is a segment of a Document produced for embedding and retrieval
+type Chunk struct {
+	ID         string    `json:"id"`
+	DocumentID string    `json:"document_id"`
+	Text       string    `json:"text"`
+	Embedding  []float64 `json:"embedding,omitempty"`
+	Index      int       `json:"index"`
+}
+
+// DocumentUpload describes a document to ingest into a bot's knowledge base.
+// Exactly one of Text, URL, or File should be set.
+type DocumentUpload struct {
+	Title string
+	Text  string
+	URL   string
+
+	// File and FileName are used for multipart uploads of PDF/HTML/Markdown
+	// documents; FileName is required when File is set. FileType is the
+	// part's MIME type (e.g. "application/pdf") and is optional — when
+	// empty, the server infers it from FileName's extension.
+	File     io.Reader
+	FileName string
+	FileType string
+}
+
+// UploadDocument ingests a document into a bot's knowledge base
+func (s *KnowledgeService) UploadDocument(botID string, upload *DocumentUpload) (*Document, error) {
+	return s.UploadDocumentContext(s.client.context(), botID, upload)
+}
+
+// UploadDocumentContext ingests a document into a bot's knowledge base
+func (s *KnowledgeService) UploadDocumentContext(ctx context.Context, botID string, upload *DocumentUpload) (*Document, error) {
+	formData := map[string]string{
+		"bot_id": botID,
+		"title":  upload.Title,
+	}
+
+	switch {
+	case upload.File != nil:
+		formData["source"] = "file"
+	case upload.URL != "":
+		formData["source"] = "url"
+		formData["url"] = upload.URL
+	default:
+		formData["source"] = "text"
+		formData["text"] = upload.Text
+	}
+
+	req := s.client.httpClient.R().SetContext(ctx).SetFormData(formData)
+	if upload.File != nil {
+		// upload.File is a single-use io.Reader that gets drained on the
+		// first attempt, so mark the request non-retryable: the
+		// client-level retry condition skips anything carrying this header.
+		req.SetHeader(noRetryHeader, "1")
+		if upload.FileType != "" {
+			req.SetMultipartField("file", upload.FileName, upload.FileType, upload.File)
+		} else {
+			req.SetFileReader("file", upload.FileName, upload.File)
+		}
+	}
+
+	resp, err := req.Post("/api/knowledge/documents")
+	if err != nil {
+		return nil, err
+	}
+
+	var doc Document
+	if err := json.Unmarshal(resp.Body(), &doc); err != nil {
+		return nil, err
+	}
+
+	return &doc, nil
+}
+
+// ListDocuments returns all documents in a bot's knowledge base
+func (s *KnowledgeService) ListDocuments(botID string) ([]Document, error) {
+	return s.ListDocumentsContext(s.client.context(), botID)
+}
+
+// ListDocumentsContext returns all documents in a bot's knowledge base
+func (s *KnowledgeService) ListDocumentsContext(ctx context.Context, botID string) ([]Document, error) {
+	resp, err := s.client.httpClient.R().
+		SetContext(ctx).
+		SetQueryParam("bot_id", botID).
+		Get("/api/knowledge/documents")
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []Document
+	if err := json.Unmarshal(resp.Body(), &docs); err != nil {
+		return nil, err
+	}
+
+	return docs, nil
+}
+
+// GetDocument returns a specific document
+func (s *KnowledgeService) GetDocument(documentID string) (*Document, error) {
+	return s.GetDocumentContext(s.client.context(), documentID)
+}
+
+// GetDocumentContext returns a specific document
+func (s *KnowledgeService) GetDocumentContext(ctx context.Context, documentID string) (*Document, error) {
+	resp, err := s.client.httpClient.R().SetContext(ctx).Get("/api/knowledge/documents/" + documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc Document
+	if err := json.Unmarshal(resp.Body(), &doc); err != nil {
+		return nil, err
+	}
+
+	return &doc, nil
+}
+
+// DeleteDocument removes a document from a bot's knowledge base
+func (s *KnowledgeService) DeleteDocument(documentID string) error {
+	return s.DeleteDocumentContext(s.client.context(), documentID)
+}
+
+// DeleteDocumentContext removes a document from a bot's knowledge base
+func (s *KnowledgeService) DeleteDocumentContext(ctx context.Context, documentID string) error {
+	_, err := s.client.httpClient.R().SetContext(ctx).Delete("/api/knowledge/documents/" + documentID)
+	return err
+}
+
+// SearchOptions configures a knowledge base semantic search
+type SearchOptions struct {
+	TopK           int     `json:"top_k,omitempty"`
+	ScoreThreshold float64 `json:"score_threshold,omitempty"`
+}
+
+// SearchResult is a single scored chunk returned from a semantic search
+type SearchResult struct {
+	Chunk Chunk   `json:"chunk"`
+	Score float64 `json:"score"`
+}
+
+// searchRequest is the wire payload for Search
+type searchRequest struct {
+	BotID          string  `json:"bot_id"`
+	Query          string  `json:"query"`
+	TopK           int     `json:"top_k,omitempty"`
+	ScoreThreshold float64 `json:"score_threshold,omitempty"`
+}
+
+// Search runs a semantic search over a bot's knowledge base
+func (s *KnowledgeService) Search(botID, query string, opts *SearchOptions) ([]SearchResult, error) {
+	return s.SearchContext(s.client.context(), botID, query, opts)
+}
+
+// SearchContext runs a semantic search over a bot's knowledge base
+func (s *KnowledgeService) SearchContext(ctx context.Context, botID, query string, opts *SearchOptions) ([]SearchResult, error) {
+	if opts == nil {
+		opts = &SearchOptions{}
+	}
+
+	body := searchRequest{
+		BotID:          botID,
+		Query:          query,
+		TopK:           opts.TopK,
+		ScoreThreshold: opts.ScoreThreshold,
+	}
+
+	resp, err := s.client.httpClient.R().SetContext(ctx).SetBody(body).Post("/api/knowledge/search")
+	if err != nil {
+		return nil, err
+	}
+
+	var results []SearchResult
+	if err := json.Unmarshal(resp.Body(), &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// CrawlOptions holds optional settings for IngestURL. Title sets the
+// document's title; the page itself is fetched by the server, not the client.
+type CrawlOptions struct {
+	Title string
+}
+
+// IngestURL fetches url server-side and submits it as a document in a bot's
+// knowledge base
+func (s *KnowledgeService) IngestURL(botID, url string, opts *CrawlOptions) (*Document, error) {
+	return s.IngestURLContext(s.client.context(), botID, url, opts)
+}
+
+// IngestURLContext fetches url server-side and submits it as a document in a
+// bot's knowledge base
+func (s *KnowledgeService) IngestURLContext(ctx context.Context, botID, url string, opts *CrawlOptions) (*Document, error) {
+	upload := &DocumentUpload{URL: url}
+	if opts != nil {
+		upload.Title = opts.Title
+	}
+
+	return s.UploadDocumentContext(ctx, botID, upload)
+}
+
+// Chunker splits document text into overlapping chunks before upload, so
+// large documents can be pre-split client-side rather than relying on the
+// server to chunk them. Size and Overlap are measured in characters.
+type Chunker struct {
+	Size    int
+	Overlap int
+}
+
+// NewChunker returns a Chunker with the given chunk size and overlap, both
+// measured in characters.
+func NewChunker(size, overlap int) *Chunker {
+	return &Chunker{Size: size, Overlap: overlap}
+}
+
+// Split breaks text into overlapping chunks according to c's Size and Overlap.
+func (c *Chunker) Split(text string) []string {
+	runes := []rune(text)
+
+	if c.Size <= 0 || len(runes) <= c.Size {
+		return []string{text}
+	}
+
+	step := c.Size - c.Overlap
+	if step <= 0 {
+		step = c.Size
+	}
+
+	var chunks []string
+	for start := 0; start < len(runes); start += step {
+		end := start + c.Size
+		if end > len(runes) {
+			end = len(runes)
+		}
+
+		chunks = append(chunks, string(runes[start:end]))
+
+		if end == len(runes) {
+			break
+		}
+	}
+
+	return chunks
+}
+
 // AnalyticsService handles analytics operations
 type AnalyticsService struct {
 	client *Client
@@ -209,3 +848,277 @@ type AnalyticsService struct {
 type WebhooksService struct {
 	client *Client
 }
+
+// Webhook represents a registered webhook subscription
+type Webhook struct {
+	ID        string   `json:"id"`
+	BotID     string   `json:"bot_id"`
+	URL       string   `json:"url"`
+	Events    []string `json:"events"`
+	Secret    string   `json:"secret"`
+	Active    bool     `json:"active"`
+	CreatedAt string   `json:"created_at"`
+}
+
+// Delivery represents a single delivery attempt of a webhook event
+type Delivery struct {
+	ID             string `json:"id"`
+	WebhookID      string `json:"webhook_id"`
+	RequestPayload string `json:"request_payload"`
+	ResponseStatus int    `json:"response_status"`
+	ResponseBody   string `json:"response_body"`
+	Success        bool   `json:"success"`
+	Attempt        int    `json:"attempt"`
+	Error          string `json:"error,omitempty"`
+	DeliveredAt    string `json:"delivered_at"`
+}
+
+// CreateWebhookRequest is the request for creating a webhook
+type CreateWebhookRequest struct {
+	BotID  string   `json:"bot_id"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+// UpdateWebhookRequest is the request for updating a webhook
+type UpdateWebhookRequest struct {
+	URL    string   `json:"url,omitempty"`
+	Events []string `json:"events,omitempty"`
+	Active *bool    `json:"active,omitempty"`
+}
+
+// Create registers a new webhook subscription
+func (s *WebhooksService) Create(req *CreateWebhookRequest) (*Webhook, error) {
+	return s.CreateContext(s.client.context(), req)
+}
+
+// CreateContext registers a new webhook subscription
+func (s *WebhooksService) CreateContext(ctx context.Context, req *CreateWebhookRequest) (*Webhook, error) {
+	resp, err := s.client.httpClient.R().SetContext(ctx).SetBody(req).Post("/api/webhooks")
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook Webhook
+	if err := json.Unmarshal(resp.Body(), &webhook); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// Get returns a specific webhook
+func (s *WebhooksService) Get(webhookID string) (*Webhook, error) {
+	return s.GetContext(s.client.context(), webhookID)
+}
+
+// GetContext returns a specific webhook
+func (s *WebhooksService) GetContext(ctx context.Context, webhookID string) (*Webhook, error) {
+	resp, err := s.client.httpClient.R().SetContext(ctx).Get("/api/webhooks/" + webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook Webhook
+	if err := json.Unmarshal(resp.Body(), &webhook); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// List returns all webhooks for a bot
+func (s *WebhooksService) List(botID string) ([]Webhook, error) {
+	return s.ListContext(s.client.context(), botID)
+}
+
+// ListContext returns all webhooks for a bot
+func (s *WebhooksService) ListContext(ctx context.Context, botID string) ([]Webhook, error) {
+	resp, err := s.client.httpClient.R().
+		SetContext(ctx).
+		SetQueryParam("bot_id", botID).
+		Get("/api/webhooks")
+	if err != nil {
+		return nil, err
+	}
+
+	var webhooks []Webhook
+	if err := json.Unmarshal(resp.Body(), &webhooks); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// Update modifies an existing webhook
+func (s *WebhooksService) Update(webhookID string, req *UpdateWebhookRequest) (*Webhook, error) {
+	return s.UpdateContext(s.client.context(), webhookID, req)
+}
+
+// UpdateContext modifies an existing webhook
+func (s *WebhooksService) UpdateContext(ctx context.Context, webhookID string, req *UpdateWebhookRequest) (*Webhook, error) {
+	resp, err := s.client.httpClient.R().SetContext(ctx).SetBody(req).Patch("/api/webhooks/" + webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook Webhook
+	if err := json.Unmarshal(resp.Body(), &webhook); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// Delete removes a webhook
+func (s *WebhooksService) Delete(webhookID string) error {
+	return s.DeleteContext(s.client.context(), webhookID)
+}
+
+// DeleteContext removes a webhook
+func (s *WebhooksService) DeleteContext(ctx context.Context, webhookID string) error {
+	_, err := s.client.httpClient.R().SetContext(ctx).Delete("/api/webhooks/" + webhookID)
+	return err
+}
+
+// ListDeliveries returns the delivery history for a webhook
+func (s *WebhooksService) ListDeliveries(webhookID string) ([]Delivery, error) {
+	return s.ListDeliveriesContext(s.client.context(), webhookID)
+}
+
+// ListDeliveriesContext returns the delivery history for a webhook
+func (s *WebhooksService) ListDeliveriesContext(ctx context.Context, webhookID string) ([]Delivery, error) {
+	resp, err := s.client.httpClient.R().SetContext(ctx).Get("/api/webhooks/" + webhookID + "/deliveries")
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []Delivery
+	if err := json.Unmarshal(resp.Body(), &deliveries); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// GetDelivery returns a specific delivery
+func (s *WebhooksService) GetDelivery(deliveryID string) (*Delivery, error) {
+	return s.GetDeliveryContext(s.client.context(), deliveryID)
+}
+
+// GetDeliveryContext returns a specific delivery
+func (s *WebhooksService) GetDeliveryContext(ctx context.Context, deliveryID string) (*Delivery, error) {
+	resp, err := s.client.httpClient.R().SetContext(ctx).Get("/api/webhooks/deliveries/" + deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	var delivery Delivery
+	if err := json.Unmarshal(resp.Body(), &delivery); err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// Redeliver retries a webhook delivery and returns the new delivery attempt
+func (s *WebhooksService) Redeliver(deliveryID string) (*Delivery, error) {
+	return s.RedeliverContext(s.client.context(), deliveryID)
+}
+
+// RedeliverContext retries a webhook delivery
+func (s *WebhooksService) RedeliverContext(ctx context.Context, deliveryID string) (*Delivery, error) {
+	resp, err := s.client.httpClient.R().SetContext(ctx).Post("/api/webhooks/deliveries/" + deliveryID + "/redeliver")
+	if err != nil {
+		return nil, err
+	}
+
+	var delivery Delivery
+	if err := json.Unmarshal(resp.Body(), &delivery); err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// Event is a typed payload dispatched to a webhook receiver, e.g.
+// "message.created" or "bot.updated"
+type Event struct {
+	Type      string          `json:"type"`
+	Timestamp string          `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// signatureHeader is the header inbound webhook requests carry their
+// HMAC-SHA256 signature in, formatted as "t=<unix-timestamp>,v1=<hex-hmac>"
+const signatureHeader = "BotBuilder-Signature"
+
+// signatureTolerance is how far a signed timestamp may drift from now before
+// VerifySignature rejects it as a replay.
+const signatureTolerance = 5 * time.Minute
+
+// VerifySignature checks the signature of an inbound webhook delivery against
+// the webhook's secret. It verifies an HMAC-SHA256 over "<timestamp>.<body>"
+// and rejects signatures whose timestamp is more than signatureTolerance from
+// now, so a captured request/header pair can't be replayed indefinitely.
+func VerifySignature(secret, body, header string) bool {
+	var timestamp, signature string
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			timestamp = kv[1]
+		case "v1":
+			signature = kv[1]
+		}
+	}
+	if timestamp == "" || signature == "" {
+		return false
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if age := time.Since(time.Unix(seconds, 0)); age < -signatureTolerance || age > signatureTolerance {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + body))
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// WebhookHandler returns an http.Handler that verifies the inbound request's
+// signature against secret, decodes it into an Event, and invokes fn.
+// Requests with a missing or invalid signature are rejected with 401, and
+// malformed bodies are rejected with 400; fn is only called for verified,
+// well-formed events.
+func WebhookHandler(secret string, fn func(*Event)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusBadRequest)
+			return
+		}
+
+		if !VerifySignature(secret, string(body), r.Header.Get(signatureHeader)) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		var event Event
+		if err := json.Unmarshal(body, &event); err != nil {
+			http.Error(w, "invalid event payload", http.StatusBadRequest)
+			return
+		}
+
+		fn(&event)
+		w.WriteHeader(http.StatusOK)
+	})
+}
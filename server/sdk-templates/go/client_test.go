@@ -0,0 +1,132 @@
+package botbuilder
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func signFor(secret string, ts int64, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("%d.%s", ts, body)))
+	return fmt.Sprintf("t=%d,v1=%s", ts, hex.EncodeToString(mac.Sum(nil)))
+}
+
+func TestVerifySignature(t *testing.T) {
+	secret := "whsec_test"
+	body := `{"type":"message.created"}`
+
+	t.Run("valid signature within tolerance", func(t *testing.T) {
+		header := signFor(secret, time.Now().Unix(), body)
+		if !VerifySignature(secret, body, header) {
+			t.Fatal("expected valid signature to verify")
+		}
+	})
+
+	t.Run("rejects wrong secret", func(t *testing.T) {
+		header := signFor(secret, time.Now().Unix(), body)
+		if VerifySignature("wrong-secret", body, header) {
+			t.Fatal("expected verification to fail with the wrong secret")
+		}
+	})
+
+	t.Run("rejects tampered body", func(t *testing.T) {
+		header := signFor(secret, time.Now().Unix(), body)
+		if VerifySignature(secret, body+"tampered", header) {
+			t.Fatal("expected verification to fail on a tampered body")
+		}
+	})
+
+	t.Run("rejects replay outside the tolerance window", func(t *testing.T) {
+		stale := time.Now().Add(-10 * time.Minute).Unix()
+		header := signFor(secret, stale, body)
+		if VerifySignature(secret, body, header) {
+			t.Fatal("expected a stale timestamp to be rejected as a replay")
+		}
+	})
+
+	t.Run("rejects malformed header", func(t *testing.T) {
+		if VerifySignature(secret, body, "garbage") {
+			t.Fatal("expected a malformed header to fail verification")
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Run("delta seconds", func(t *testing.T) {
+		wait, ok := parseRetryAfter("120")
+		if !ok || wait != 120*time.Second {
+			t.Fatalf("got (%v, %v), want (120s, true)", wait, ok)
+		}
+	})
+
+	t.Run("http-date in the future", func(t *testing.T) {
+		when := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+		wait, ok := parseRetryAfter(when)
+		if !ok {
+			t.Fatal("expected an HTTP-date header to parse")
+		}
+		if wait <= 0 || wait > 31*time.Second {
+			t.Fatalf("got wait %v, want ~30s", wait)
+		}
+	})
+
+	t.Run("http-date in the past", func(t *testing.T) {
+		when := time.Now().Add(-30 * time.Second).UTC().Format(http.TimeFormat)
+		wait, ok := parseRetryAfter(when)
+		if !ok {
+			t.Fatal("expected a past HTTP-date header to still be recognized")
+		}
+		if wait != 0 {
+			t.Fatalf("got wait %v, want 0", wait)
+		}
+	})
+
+	t.Run("empty header", func(t *testing.T) {
+		if _, ok := parseRetryAfter(""); ok {
+			t.Fatal("expected an empty header to report not-ok")
+		}
+	})
+
+	t.Run("garbage header", func(t *testing.T) {
+		if _, ok := parseRetryAfter("not-a-valid-value"); ok {
+			t.Fatal("expected a garbage header to report not-ok")
+		}
+	})
+}
+
+func TestChunkerSplit(t *testing.T) {
+	t.Run("short text returned as a single chunk", func(t *testing.T) {
+		c := NewChunker(10, 2)
+		got := c.Split("hello")
+		want := []string{"hello"}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("splits with overlap", func(t *testing.T) {
+		c := NewChunker(4, 1)
+		got := c.Split("abcdefgh")
+		want := []string{"abcd", "defg", "gh"}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("does not split multi-byte runes", func(t *testing.T) {
+		c := NewChunker(5, 0)
+		text := "héllo wörld, 日本語テスト"
+		for _, chunk := range c.Split(text) {
+			if !utf8.ValidString(chunk) {
+				t.Fatalf("chunk %q is not valid UTF-8", chunk)
+			}
+		}
+	})
+}